@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penguinrobotics/registration-checker/internal/check"
+)
+
+var checkEventIDs []string
+
+func init() {
+	checkCmd.Flags().StringSliceVar(&checkEventIDs, "event-id", nil, "event ID to check (repeatable)")
+	checkCmd.MarkFlagRequired("event-id")
+	rootCmd.AddCommand(checkCmd)
+}
+
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Fetch current registrations and notify Slack of any changes",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if apiToken == "" {
+			return fmt.Errorf("--api-token or API_TOKEN is required")
+		}
+
+		st, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer st.Close()
+
+		for _, eventID := range checkEventIDs {
+			if err := check.Run(cmd.Context(), apiToken, slackWebhook, st, eventID); err != nil {
+				log.Printf("event %s: %v", eventID, err)
+			}
+		}
+		return nil
+	},
+}
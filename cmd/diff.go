@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penguinrobotics/registration-checker/internal/diff"
+	"github.com/penguinrobotics/registration-checker/internal/robotevents"
+)
+
+var (
+	diffEventID string
+	diffFrom    string
+	diffTo      string
+)
+
+func init() {
+	diffCmd.Flags().StringVar(&diffEventID, "event-id", "", "event ID (used for display only)")
+	diffCmd.Flags().StringVar(&diffFrom, "from", "", "path to the earlier snapshot JSON file")
+	diffCmd.Flags().StringVar(&diffTo, "to", "", "path to the later snapshot JSON file")
+	diffCmd.MarkFlagRequired("from")
+	diffCmd.MarkFlagRequired("to")
+	rootCmd.AddCommand(diffCmd)
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Compare two saved team snapshots without calling the RobotEvents API",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fromTeams, err := loadSnapshotFile(diffFrom)
+		if err != nil {
+			return fmt.Errorf("reading --from snapshot: %w", err)
+		}
+		toTeams, err := loadSnapshotFile(diffTo)
+		if err != nil {
+			return fmt.Errorf("reading --to snapshot: %w", err)
+		}
+
+		d := diff.DiffTeams(fromTeams.Data, toTeams.Data)
+		if len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0 {
+			fmt.Printf("No registration changes for %s.\n", diffEventID)
+			return nil
+		}
+
+		if len(d.Removed) > 0 {
+			fmt.Printf("Missing teams for %s:\n", diffEventID)
+			for _, team := range d.Removed {
+				fmt.Printf("  %s - %s\n", team.Number, team.Organization)
+			}
+		}
+		if len(d.Added) > 0 {
+			fmt.Printf("New teams for %s:\n", diffEventID)
+			for _, team := range d.Added {
+				fmt.Printf("  %s - %s\n", team.Number, team.Organization)
+			}
+		}
+		if len(d.Changed) > 0 {
+			fmt.Printf("Changed teams for %s:\n", diffEventID)
+			for _, change := range d.Changed {
+				fmt.Printf("  %s - %s: %s\n", change.Team.Number, change.Team.Organization, strings.Join(change.Fields, "; "))
+			}
+		}
+		return nil
+	},
+}
+
+func loadSnapshotFile(path string) (*robotevents.APIResponse, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var resp robotevents.APIResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
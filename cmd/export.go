@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penguinrobotics/registration-checker/internal/robotevents"
+)
+
+var (
+	exportEventID string
+	exportFormat  string
+)
+
+func init() {
+	exportCmd.Flags().StringVar(&exportEventID, "event-id", "", "event ID to export")
+	exportCmd.Flags().StringVar(&exportFormat, "format", "csv", "output format: csv or json")
+	exportCmd.MarkFlagRequired("event-id")
+	rootCmd.AddCommand(exportCmd)
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the current team list for an event to stdout",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if apiToken == "" {
+			return fmt.Errorf("--api-token or API_TOKEN is required")
+		}
+
+		teams, err := robotevents.FetchTeams(cmd.Context(), apiToken, exportEventID)
+		if err != nil {
+			return fmt.Errorf("fetching teams: %w", err)
+		}
+
+		switch exportFormat {
+		case "csv":
+			return writeCSV(os.Stdout, teams.Data)
+		case "json":
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(teams.Data)
+		default:
+			return fmt.Errorf("unsupported --format %q (want csv or json)", exportFormat)
+		}
+	},
+}
+
+func writeCSV(w io.Writer, teams []robotevents.Team) error {
+	cw := csv.NewWriter(w)
+	defer cw.Flush()
+
+	if err := cw.Write([]string{"number", "team_name", "robot_name", "organization", "city", "region", "country"}); err != nil {
+		return err
+	}
+	for _, team := range teams {
+		row := []string{
+			team.Number,
+			team.TeamName,
+			team.RobotName,
+			team.Organization,
+			team.Location.City,
+			team.Location.Region,
+			team.Location.Country,
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	return cw.Error()
+}
@@ -0,0 +1,68 @@
+// Package cmd implements the registration-checker CLI.
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penguinrobotics/registration-checker/internal/store"
+)
+
+var (
+	apiToken     string
+	slackWebhook string
+	stateDir     string
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "registration-checker",
+	Short: "Track RobotEvents team registration changes and notify Slack",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		apiToken = resolveFlag(apiToken, "API_TOKEN")
+		slackWebhook = resolveFlag(slackWebhook, "SLACK_WEBHOOK_URL")
+		stateDir = resolveFlag(stateDir, "STATE_DIR")
+		if stateDir == "" {
+			stateDir = "."
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&apiToken, "api-token", "", "RobotEvents API token (env API_TOKEN)")
+	rootCmd.PersistentFlags().StringVar(&slackWebhook, "slack-webhook", "", "Slack incoming webhook URL (env SLACK_WEBHOOK_URL)")
+	rootCmd.PersistentFlags().StringVar(&stateDir, "state-dir", "", "directory to read/write snapshot state (env STATE_DIR, default \".\")")
+}
+
+// resolveFlag falls back to the given environment variable when the flag
+// was left at its zero value, so existing cron jobs configured with env
+// vars keep working unchanged.
+func resolveFlag(value, envKey string) string {
+	if value != "" {
+		return value
+	}
+	return os.Getenv(envKey)
+}
+
+// Execute runs the root command.
+func Execute() error {
+	return rootCmd.Execute()
+}
+
+// openStore opens the registration history store under stateDir, importing
+// any legacy "<eventID>_teams.json" snapshots on first use. Callers must
+// Close it when done.
+func openStore() (*store.BoltStore, error) {
+	st, err := store.Open(filepath.Join(stateDir, "registrations.db"))
+	if err != nil {
+		return nil, fmt.Errorf("opening state store: %w", err)
+	}
+	if err := st.ImportJSONSnapshots(stateDir); err != nil {
+		st.Close()
+		return nil, fmt.Errorf("migrating legacy snapshots: %w", err)
+	}
+	return st, nil
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penguinrobotics/registration-checker/internal/bot"
+)
+
+var (
+	serveSlackAppToken string
+	serveSlackBotToken string
+	servePollInterval  time.Duration
+)
+
+func init() {
+	serveCmd.Flags().StringVar(&serveSlackAppToken, "slack-app-token", "", "Slack app-level token (xapp-...), env SLACK_APP_TOKEN")
+	serveCmd.Flags().StringVar(&serveSlackBotToken, "slack-bot-token", "", "Slack bot token (xoxb-...), env SLACK_BOT_TOKEN")
+	serveCmd.Flags().DurationVar(&servePollInterval, "poll-interval", 15*time.Minute, "how often to poll subscribed events")
+	rootCmd.AddCommand(serveCmd)
+}
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run as a long-lived Slack app serving /reg-check, /reg-watch, and /reg-unwatch",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if apiToken == "" {
+			return fmt.Errorf("--api-token or API_TOKEN is required")
+		}
+		appToken := resolveFlag(serveSlackAppToken, "SLACK_APP_TOKEN")
+		botToken := resolveFlag(serveSlackBotToken, "SLACK_BOT_TOKEN")
+		if appToken == "" || botToken == "" {
+			return fmt.Errorf("--slack-app-token/SLACK_APP_TOKEN and --slack-bot-token/SLACK_BOT_TOKEN are required")
+		}
+
+		subs, err := bot.LoadSubscriptions(filepath.Join(stateDir, "subscriptions.json"))
+		if err != nil {
+			return fmt.Errorf("loading subscriptions: %w", err)
+		}
+
+		st, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer st.Close()
+
+		b := bot.New(appToken, botToken, apiToken, st, subs, servePollInterval)
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer stop()
+		return b.Run(ctx)
+	},
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penguinrobotics/registration-checker/internal/check"
+)
+
+var (
+	watchInterval time.Duration
+	watchEventIDs []string
+)
+
+func init() {
+	watchCmd.Flags().DurationVar(&watchInterval, "interval", 15*time.Minute, "how often to poll each event")
+	watchCmd.Flags().StringSliceVar(&watchEventIDs, "events", nil, "event IDs to watch (repeatable)")
+	watchCmd.MarkFlagRequired("events")
+	rootCmd.AddCommand(watchCmd)
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Poll one or more events on an interval and notify Slack of changes",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if apiToken == "" {
+			return fmt.Errorf("--api-token or API_TOKEN is required")
+		}
+
+		st, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer st.Close()
+
+		poll := func() {
+			for _, eventID := range watchEventIDs {
+				if err := check.Run(cmd.Context(), apiToken, slackWebhook, st, eventID); err != nil {
+					log.Printf("event %s: %v", eventID, err)
+				}
+			}
+		}
+
+		poll()
+		ticker := time.NewTicker(watchInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			poll()
+		}
+		return nil
+	},
+}
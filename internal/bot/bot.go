@@ -0,0 +1,277 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/socketmode"
+
+	"github.com/penguinrobotics/registration-checker/internal/check"
+	"github.com/penguinrobotics/registration-checker/internal/diff"
+	"github.com/penguinrobotics/registration-checker/internal/notify"
+	"github.com/penguinrobotics/registration-checker/internal/robotevents"
+	"github.com/penguinrobotics/registration-checker/internal/store"
+)
+
+// Bot is a long-running Slack Socket Mode connection that serves
+// /reg-check, /reg-watch, and /reg-unwatch and polls subscribed events.
+type Bot struct {
+	client       *socketmode.Client
+	api          *slack.Client
+	router       *Router
+	subs         *Subscriptions
+	apiToken     string // RobotEvents API token
+	store        store.Store
+	pollInterval time.Duration
+	ctx          context.Context // set by Run; valid for the bot's lifetime
+}
+
+// New builds a Bot. appToken and botToken are the Slack app-level
+// ("xapp-...") and bot ("xoxb-...") tokens; apiToken authenticates against
+// RobotEvents.
+func New(appToken, botToken, apiToken string, st store.Store, subs *Subscriptions, pollInterval time.Duration) *Bot {
+	api := slack.New(botToken, slack.OptionAppLevelToken(appToken))
+	b := &Bot{
+		client:       socketmode.New(api),
+		api:          api,
+		subs:         subs,
+		apiToken:     apiToken,
+		store:        st,
+		pollInterval: pollInterval,
+	}
+	b.router = b.buildRouter()
+	return b
+}
+
+func (b *Bot) buildRouter() *Router {
+	r := NewRouter()
+	r.Register("/reg-check", HandlerFunc(b.handleCheck))
+	r.Register("/reg-watch", HandlerFunc(b.handleWatch))
+	r.Register("/reg-unwatch", HandlerFunc(b.handleUnwatch))
+	return r
+}
+
+// Run connects to Slack and blocks, handling slash commands and polling
+// subscribed events, until ctx is canceled.
+func (b *Bot) Run(ctx context.Context) error {
+	b.ctx = ctx
+	go b.pollLoop(ctx)
+
+	go func() {
+		for evt := range b.client.Events {
+			switch evt.Type {
+			case socketmode.EventTypeSlashCommand:
+				cmd, ok := evt.Data.(slack.SlashCommand)
+				if !ok {
+					continue
+				}
+				b.handleEvent(evt, cmd)
+			default:
+				// Other event types (interactions, events API callbacks) are
+				// not handled yet.
+			}
+		}
+	}()
+
+	return b.client.RunContext(ctx)
+}
+
+func (b *Bot) handleEvent(evt socketmode.Event, sc slack.SlashCommand) {
+	cmd := SlashCommand{
+		Command:     sc.Command,
+		Text:        sc.Text,
+		ChannelID:   sc.ChannelID,
+		UserID:      sc.UserID,
+		ResponseURL: sc.ResponseURL,
+	}
+
+	// Slack requires an ack within 3 seconds; route synchronously since our
+	// handlers only do local bookkeeping or kick off a goroutine themselves.
+	resp := b.router.Route(cmd)
+
+	payload := map[string]interface{}{
+		"response_type": resp.ResponseType,
+		"text":          resp.Text,
+	}
+	if evt.Request != nil {
+		b.client.Ack(*evt.Request, payload)
+	}
+}
+
+func (b *Bot) handleCheck(cmd SlashCommand) Response {
+	eventID := strings.TrimSpace(cmd.Text)
+	if eventID == "" {
+		return Response{Text: "usage: /reg-check <event-id>", ResponseType: "ephemeral"}
+	}
+
+	go b.postCheckResultToResponseURL(eventID, cmd.ResponseURL)
+	return Response{Text: fmt.Sprintf("Checking %s…", eventID), ResponseType: "ephemeral"}
+}
+
+func (b *Bot) handleWatch(cmd SlashCommand) Response {
+	fields := strings.Fields(cmd.Text)
+	if len(fields) != 2 {
+		return Response{Text: "usage: /reg-watch <event-id> #channel", ResponseType: "ephemeral"}
+	}
+	eventID, channelID := fields[0], parseChannelMention(fields[1])
+
+	if err := b.subs.Watch(eventID, channelID); err != nil {
+		return Response{Text: fmt.Sprintf("failed to save subscription: %v", err), ResponseType: "ephemeral"}
+	}
+	return Response{Text: fmt.Sprintf("Watching %s, posting changes to <#%s>.", eventID, channelID), ResponseType: "ephemeral"}
+}
+
+func (b *Bot) handleUnwatch(cmd SlashCommand) Response {
+	eventID := strings.TrimSpace(cmd.Text)
+	if eventID == "" {
+		return Response{Text: "usage: /reg-unwatch <event-id>", ResponseType: "ephemeral"}
+	}
+
+	if err := b.subs.Unwatch(eventID); err != nil {
+		return Response{Text: fmt.Sprintf("failed to remove subscription: %v", err), ResponseType: "ephemeral"}
+	}
+	return Response{Text: fmt.Sprintf("Stopped watching %s.", eventID), ResponseType: "ephemeral"}
+}
+
+// parseChannelMention extracts the channel ID from Slack's expanded mention
+// syntax ("<#C0123|general>"), falling back to the raw token for plain IDs.
+func parseChannelMention(token string) string {
+	token = strings.TrimPrefix(token, "<#")
+	token = strings.TrimSuffix(token, ">")
+	if idx := strings.Index(token, "|"); idx != -1 {
+		token = token[:idx]
+	}
+	return token
+}
+
+// pollLoop periodically checks every subscribed event and posts any
+// registration changes (added, removed, restored, or edited teams) into its
+// mapped channel.
+func (b *Bot) pollLoop(ctx context.Context) {
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for eventID, channelID := range b.subs.All() {
+			if err := b.postCheckResult(eventID, channelID); err != nil {
+				log.Printf("event %s: %v", eventID, err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// postCheckResult reports registration changes for eventID into channelID
+// via the Slack Web API, using the same Block Kit layout as the check and
+// watch subcommands. Used by the poll loop, which has no response_url to
+// reply to.
+func (b *Bot) postCheckResult(eventID, channelID string) error {
+	d, hasPrevious, err := check.FetchAndDiff(b.ctx, b.apiToken, b.store, eventID)
+	if err != nil {
+		return err
+	}
+	if !hasPrevious || (len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Restored) == 0 && len(d.Changed) == 0) {
+		return nil
+	}
+
+	event, err := robotevents.FetchEventMeta(b.apiToken, eventID)
+	if err != nil {
+		return fmt.Errorf("fetching event metadata: %w", err)
+	}
+
+	opts, err := blocksMsgOptions(event.Name, event, d)
+	if err != nil {
+		return err
+	}
+	_, _, err = b.api.PostMessage(channelID, opts...)
+	return err
+}
+
+// postCheckResultToResponseURL reports the result of an on-demand
+// /reg-check back to the invoking channel via Slack's response_url, since
+// the initial ack must happen within 3 seconds and the check itself may
+// take longer. The result is rendered with the same Block Kit layout the
+// webhook-based subcommands use, so /reg-check gets the same per-team
+// detail operators see from check and watch.
+func (b *Bot) postCheckResultToResponseURL(eventID, responseURL string) error {
+	d, hasPrevious, err := check.FetchAndDiff(b.ctx, b.apiToken, b.store, eventID)
+	if err != nil {
+		return postTextToResponseURL(responseURL, fmt.Sprintf("error checking %s: %v", eventID, err))
+	}
+	if !hasPrevious {
+		return postTextToResponseURL(responseURL, fmt.Sprintf("No previous team data to compare for event %s.", eventID))
+	}
+
+	event, err := robotevents.FetchEventMeta(b.apiToken, eventID)
+	if err != nil {
+		return postTextToResponseURL(responseURL, fmt.Sprintf("error checking %s: %v", eventID, err))
+	}
+
+	return postBlocksToResponseURL(responseURL, notify.BuildBlocksMessage(event.Name, event, d))
+}
+
+// blocksMsgOptions decodes a notify.BuildBlocksMessage payload into Slack
+// Web API message options, so bot.go posts the same blocks/attachments the
+// webhook-based subcommands send rather than a second plain-text renderer.
+func blocksMsgOptions(eventName string, event *robotevents.EventMeta, d diff.TeamDiff) ([]slack.MsgOption, error) {
+	var msg slack.Msg
+	if err := json.Unmarshal(notify.BuildBlocksMessage(eventName, event, d), &msg); err != nil {
+		return nil, fmt.Errorf("decoding blocks payload: %w", err)
+	}
+
+	opts := []slack.MsgOption{slack.MsgOptionBlocks(msg.Blocks.BlockSet...)}
+	if len(msg.Attachments) > 0 {
+		opts = append(opts, slack.MsgOptionAttachments(msg.Attachments...))
+	}
+	return opts, nil
+}
+
+func postTextToResponseURL(responseURL, text string) error {
+	return postJSONToResponseURL(responseURL, map[string]interface{}{
+		"response_type": "in_channel",
+		"text":          text,
+	})
+}
+
+// postBlocksToResponseURL posts a notify.BuildBlocksMessage payload to
+// responseURL, adding the response_type field Slack requires on
+// response_url payloads (BuildBlocksMessage only knows about blocks and
+// attachments, since that's all the incoming-webhook API needs).
+func postBlocksToResponseURL(responseURL string, blocksPayload []byte) error {
+	var msg map[string]interface{}
+	if err := json.Unmarshal(blocksPayload, &msg); err != nil {
+		return fmt.Errorf("decoding blocks payload: %w", err)
+	}
+	msg["response_type"] = "in_channel"
+	return postJSONToResponseURL(responseURL, msg)
+}
+
+func postJSONToResponseURL(responseURL string, body map[string]interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.Post(responseURL, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("response_url post failed, status code: %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Package bot runs registration-checker as a long-lived Slack app using
+// Socket Mode, handling slash commands and polling subscribed events.
+package bot
+
+import "fmt"
+
+// SlashCommand is the subset of Slack's slash command payload the router
+// cares about.
+type SlashCommand struct {
+	Command     string // e.g. "/reg-check"
+	Text        string // everything after the command name
+	ChannelID   string
+	UserID      string
+	ResponseURL string
+}
+
+// Response is what a Handler sends back. Text is used both for the
+// immediate (within Slack's 3-second budget) ack and, if a handler chooses
+// to respond synchronously, as the final reply.
+type Response struct {
+	Text         string
+	ResponseType string // "ephemeral" or "in_channel"
+}
+
+// Handler handles one slash command invocation.
+type Handler interface {
+	Handle(cmd SlashCommand) Response
+}
+
+// HandlerFunc adapts a plain function to a Handler.
+type HandlerFunc func(cmd SlashCommand) Response
+
+// Handle calls f(cmd).
+func (f HandlerFunc) Handle(cmd SlashCommand) Response { return f(cmd) }
+
+// Router dispatches slash commands to registered handlers by command name,
+// so new commands can be added without touching the Socket Mode plumbing.
+type Router struct {
+	handlers map[string]Handler
+}
+
+// NewRouter returns an empty Router.
+func NewRouter() *Router {
+	return &Router{handlers: make(map[string]Handler)}
+}
+
+// Register associates a slash command name (e.g. "/reg-check") with a
+// Handler.
+func (r *Router) Register(command string, h Handler) {
+	r.handlers[command] = h
+}
+
+// Route dispatches cmd to its registered handler, or returns a helpful
+// error response if the command is unknown.
+func (r *Router) Route(cmd SlashCommand) Response {
+	h, ok := r.handlers[cmd.Command]
+	if !ok {
+		return Response{
+			Text:         fmt.Sprintf("Unknown command %q", cmd.Command),
+			ResponseType: "ephemeral",
+		}
+	}
+	return h.Handle(cmd)
+}
@@ -0,0 +1,71 @@
+package bot
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+// Subscriptions maps watched event IDs to the Slack channel they should be
+// reported into, persisted to disk so restarts don't drop them.
+type Subscriptions struct {
+	mu   sync.Mutex
+	path string
+	byID map[string]string // eventID -> channelID
+}
+
+// LoadSubscriptions reads subscriptions from path, treating a missing file
+// as an empty set.
+func LoadSubscriptions(path string) (*Subscriptions, error) {
+	s := &Subscriptions{path: path, byID: make(map[string]string)}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.byID); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Watch records that eventID's diffs should be posted to channelID.
+func (s *Subscriptions) Watch(eventID, channelID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.byID[eventID] = channelID
+	return s.save()
+}
+
+// Unwatch removes a subscription. It is a no-op if eventID isn't watched.
+func (s *Subscriptions) Unwatch(eventID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.byID, eventID)
+	return s.save()
+}
+
+// All returns a snapshot of the current event -> channel mapping.
+func (s *Subscriptions) All() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make(map[string]string, len(s.byID))
+	for eventID, channelID := range s.byID {
+		out[eventID] = channelID
+	}
+	return out
+}
+
+// save persists the subscription map. Callers must hold s.mu.
+func (s *Subscriptions) save() error {
+	data, err := json.MarshalIndent(s.byID, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(s.path, data, 0644)
+}
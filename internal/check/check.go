@@ -0,0 +1,102 @@
+// Package check implements the fetch-diff-notify flow shared by the check,
+// watch, and serve subcommands.
+package check
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/penguinrobotics/registration-checker/internal/diff"
+	"github.com/penguinrobotics/registration-checker/internal/notify"
+	"github.com/penguinrobotics/registration-checker/internal/robotevents"
+	"github.com/penguinrobotics/registration-checker/internal/store"
+)
+
+// Run fetches the current teams for eventID, diffs them against the latest
+// snapshot in st, records the new snapshot, and posts a Slack message to
+// webhookURL summarizing any additions, removals, re-registrations, or
+// field changes.
+func Run(ctx context.Context, token, webhookURL string, st store.Store, eventID string) error {
+	fmt.Printf("Processing event ID: %s\n", eventID)
+
+	d, hasPrevious, err := FetchAndDiff(ctx, token, st, eventID)
+	if err != nil {
+		return err
+	}
+	if !hasPrevious {
+		fmt.Printf("No previous team data to compare for event %s.\n", eventID)
+		return nil
+	}
+	if len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Restored) == 0 && len(d.Changed) == 0 {
+		return nil
+	}
+
+	if webhookURL == "" {
+		return fmt.Errorf("--slack-webhook or SLACK_WEBHOOK_URL is required to report registration changes")
+	}
+
+	event, err := robotevents.FetchEventMeta(token, eventID)
+	if err != nil {
+		return fmt.Errorf("fetching event metadata: %w", err)
+	}
+
+	return notify.SendSlackMessage(webhookURL, event.Name, event, d)
+}
+
+// FetchAndDiff fetches the current teams for eventID, diffs them against the
+// latest snapshot in st, and records the new snapshot. hasPrevious reports
+// whether a prior snapshot existed to diff against; when it is false, d is
+// always zero-valued and callers should not treat that as "no changes".
+// This is the step shared by the check, watch, and serve subcommands.
+func FetchAndDiff(ctx context.Context, token string, st store.Store, eventID string) (d diff.TeamDiff, hasPrevious bool, err error) {
+	currTeams, err := robotevents.FetchTeams(ctx, token, eventID)
+	if err != nil {
+		return diff.TeamDiff{}, false, fmt.Errorf("fetching teams: %w", err)
+	}
+
+	prevSnapshot, err := st.LatestSnapshot(eventID)
+	if err != nil {
+		return diff.TeamDiff{}, false, fmt.Errorf("reading previous snapshot: %w", err)
+	}
+
+	previouslySeen, err := previouslySeenTeamIDs(st, eventID, prevSnapshot)
+	if err != nil {
+		return diff.TeamDiff{}, false, fmt.Errorf("reading snapshot history: %w", err)
+	}
+
+	if err := st.SaveSnapshot(eventID, time.Now(), currTeams.Data); err != nil {
+		return diff.TeamDiff{}, false, fmt.Errorf("saving snapshot: %w", err)
+	}
+
+	if prevSnapshot == nil {
+		return diff.TeamDiff{}, false, nil
+	}
+
+	return diff.DiffTeams(prevSnapshot.Teams, currTeams.Data).MarkRestored(previouslySeen), true, nil
+}
+
+// previouslySeenTeamIDs returns the set of team IDs observed in any
+// snapshot strictly before prevSnapshot, used to tell a genuinely new
+// registration (diff.TeamDiff.Added) apart from a re-registration
+// (diff.TeamDiff.Restored).
+func previouslySeenTeamIDs(st store.Store, eventID string, prevSnapshot *store.Snapshot) (map[int]bool, error) {
+	seen := make(map[int]bool)
+	if prevSnapshot == nil {
+		return seen, nil
+	}
+
+	history, err := st.Snapshots(eventID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	for _, snap := range history {
+		if !snap.Timestamp.Before(prevSnapshot.Timestamp) {
+			continue
+		}
+		for _, team := range snap.Teams {
+			seen[team.ID] = true
+		}
+	}
+	return seen, nil
+}
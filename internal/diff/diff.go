@@ -0,0 +1,100 @@
+// Package diff compares team snapshots to find registration changes:
+// additions, removals, re-registrations, and field-level edits.
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/penguinrobotics/registration-checker/internal/robotevents"
+)
+
+// FieldChange describes one team whose tracked fields differ between two
+// snapshots.
+type FieldChange struct {
+	Team   robotevents.Team
+	Fields []string // human-readable "Field: old -> new" entries
+}
+
+// TeamDiff categorizes how a set of teams changed between two snapshots.
+type TeamDiff struct {
+	Added    []robotevents.Team // present in curr, not in prev
+	Removed  []robotevents.Team // present in prev, not in curr
+	Restored []robotevents.Team // present in curr, not in prev, but seen in an earlier snapshot (set by MarkRestored)
+	Changed  []FieldChange      // present in both, with differing tracked fields
+}
+
+// DiffTeams compares a previous and current snapshot of a single event's
+// teams. It does not know about "Restored" on its own since that requires
+// looking further back than one prior snapshot; callers with access to
+// snapshot history should use MarkRestored on the result.
+func DiffTeams(prev, curr []robotevents.Team) TeamDiff {
+	prevByID := make(map[int]robotevents.Team, len(prev))
+	for _, team := range prev {
+		prevByID[team.ID] = team
+	}
+	currByID := make(map[int]robotevents.Team, len(curr))
+	for _, team := range curr {
+		currByID[team.ID] = team
+	}
+
+	var d TeamDiff
+	for _, team := range curr {
+		if _, ok := prevByID[team.ID]; !ok {
+			d.Added = append(d.Added, team)
+		}
+	}
+	for _, team := range prev {
+		if _, ok := currByID[team.ID]; !ok {
+			d.Removed = append(d.Removed, team)
+		}
+	}
+	for _, team := range curr {
+		old, ok := prevByID[team.ID]
+		if !ok {
+			continue
+		}
+		if fields := fieldChanges(old, team); len(fields) > 0 {
+			d.Changed = append(d.Changed, FieldChange{Team: team, Fields: fields})
+		}
+	}
+	return d
+}
+
+// MarkRestored moves entries of d.Added whose team ID appears in
+// previouslySeen (team IDs observed in some snapshot before prev) into
+// d.Restored, leaving the rest classified as genuinely new registrations.
+func (d TeamDiff) MarkRestored(previouslySeen map[int]bool) TeamDiff {
+	var added []robotevents.Team
+	for _, team := range d.Added {
+		if previouslySeen[team.ID] {
+			d.Restored = append(d.Restored, team)
+		} else {
+			added = append(added, team)
+		}
+	}
+	d.Added = added
+	return d
+}
+
+// fieldChanges reports the tracked fields that differ between two
+// observations of the same team ID. A team ID being reused for an
+// effectively different team (new org, new location) is reported the same
+// way as any other edit, since from the ID's perspective it's one team.
+func fieldChanges(old, new robotevents.Team) []string {
+	var changes []string
+	if old.Organization != new.Organization {
+		changes = append(changes, fmt.Sprintf("Organization: %q -> %q", old.Organization, new.Organization))
+	}
+	if old.RobotName != new.RobotName {
+		changes = append(changes, fmt.Sprintf("RobotName: %q -> %q", old.RobotName, new.RobotName))
+	}
+	if old.Location.City != new.Location.City || old.Location.Region != new.Location.Region || old.Location.Country != new.Location.Country {
+		changes = append(changes, fmt.Sprintf("Location: %q -> %q", formatLocation(old), formatLocation(new)))
+	}
+	return changes
+}
+
+func formatLocation(team robotevents.Team) string {
+	return strings.Join([]string{team.Location.City, team.Location.Region, team.Location.Country}, ", ")
+}
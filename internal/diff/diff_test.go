@@ -0,0 +1,108 @@
+package diff
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/penguinrobotics/registration-checker/internal/robotevents"
+)
+
+func team(id int, number, org string) robotevents.Team {
+	return robotevents.Team{ID: id, Number: number, Organization: org}
+}
+
+func teamIDs(teams []robotevents.Team) []int {
+	if len(teams) == 0 {
+		return nil
+	}
+	ids := make([]int, len(teams))
+	for i, t := range teams {
+		ids[i] = t.ID
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func TestDiffTeams(t *testing.T) {
+	cases := []struct {
+		name        string
+		prev, curr  []robotevents.Team
+		wantAdded   []int
+		wantRemoved []int
+		wantChanged []int
+	}{
+		{
+			name:        "no changes",
+			prev:        []robotevents.Team{team(1, "100A", "Acme")},
+			curr:        []robotevents.Team{team(1, "100A", "Acme")},
+			wantAdded:   nil,
+			wantRemoved: nil,
+			wantChanged: nil,
+		},
+		{
+			name:      "team added",
+			prev:      []robotevents.Team{team(1, "100A", "Acme")},
+			curr:      []robotevents.Team{team(1, "100A", "Acme"), team(2, "200B", "Beta")},
+			wantAdded: []int{2},
+		},
+		{
+			name:        "team removed",
+			prev:        []robotevents.Team{team(1, "100A", "Acme"), team(2, "200B", "Beta")},
+			curr:        []robotevents.Team{team(1, "100A", "Acme")},
+			wantRemoved: []int{2},
+		},
+		{
+			name:        "organization changed",
+			prev:        []robotevents.Team{team(1, "100A", "Acme")},
+			curr:        []robotevents.Team{team(1, "100A", "Acme Robotics")},
+			wantChanged: []int{1},
+		},
+		{
+			name:        "case-only organization change is still a change",
+			prev:        []robotevents.Team{team(1, "100A", "Acme")},
+			curr:        []robotevents.Team{team(1, "100A", "ACME")},
+			wantChanged: []int{1},
+		},
+		{
+			name:        "team ID reuse reports as a change, not add+remove",
+			prev:        []robotevents.Team{team(1, "100A", "Acme")},
+			curr:        []robotevents.Team{team(1, "100A", "Totally Different Org")},
+			wantChanged: []int{1},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := DiffTeams(tc.prev, tc.curr)
+
+			if got := teamIDs(d.Added); !reflect.DeepEqual(got, tc.wantAdded) {
+				t.Errorf("Added = %v, want %v", got, tc.wantAdded)
+			}
+			if got := teamIDs(d.Removed); !reflect.DeepEqual(got, tc.wantRemoved) {
+				t.Errorf("Removed = %v, want %v", got, tc.wantRemoved)
+			}
+			var changedIDs []int
+			for _, c := range d.Changed {
+				changedIDs = append(changedIDs, c.Team.ID)
+			}
+			sort.Ints(changedIDs)
+			if !reflect.DeepEqual(changedIDs, tc.wantChanged) {
+				t.Errorf("Changed = %v, want %v", changedIDs, tc.wantChanged)
+			}
+		})
+	}
+}
+
+func TestMarkRestored(t *testing.T) {
+	d := TeamDiff{Added: []robotevents.Team{team(1, "100A", "Acme"), team(2, "200B", "Beta")}}
+
+	d = d.MarkRestored(map[int]bool{1: true})
+
+	if got := teamIDs(d.Restored); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("Restored = %v, want [1]", got)
+	}
+	if got := teamIDs(d.Added); !reflect.DeepEqual(got, []int{2}) {
+		t.Errorf("Added = %v, want [2]", got)
+	}
+}
@@ -0,0 +1,180 @@
+// Package notify renders registration check results as Slack messages and
+// delivers them to a webhook.
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/penguinrobotics/registration-checker/internal/diff"
+	"github.com/penguinrobotics/registration-checker/internal/robotevents"
+)
+
+// slackBlock is a loosely-typed Block Kit block. Block Kit has dozens of
+// block/element shapes, so we build these as maps rather than modeling the
+// full schema.
+type slackBlock map[string]interface{}
+
+// slackAttachment wraps a set of blocks in a color bar, since Block Kit
+// itself has no notion of color outside of the legacy attachments API.
+type slackAttachment struct {
+	Color  string       `json:"color"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type slackMessage struct {
+	Blocks      []slackBlock      `json:"blocks,omitempty"`
+	Attachments []slackAttachment `json:"attachments,omitempty"`
+}
+
+const (
+	colorRemoved  = "#d32f2f" // red: no longer registered
+	colorRestored = "#2e7d32" // green: re-registered after being missing
+	colorAdded    = "#1565c0" // blue: newly registered
+	colorChanged  = "#f9a825" // amber: details edited
+)
+
+// teamRobotEventsURL best-effort links to the team's robotevents.com page.
+// The public team profile URL is keyed on team number, not team ID.
+func teamRobotEventsURL(team robotevents.Team) string {
+	return fmt.Sprintf("https://www.robotevents.com/teams/%s", team.Number)
+}
+
+// teamSectionBlock renders a single team as a section block with a button
+// linking out to its robotevents.com profile.
+func teamSectionBlock(team robotevents.Team, detail string) slackBlock {
+	location := strings.TrimSpace(strings.Join([]string{team.Location.City, team.Location.Region}, ", "))
+	location = strings.Trim(location, ", ")
+	if location == "" {
+		location = "Unknown location"
+	}
+	if detail == "" {
+		detail = location
+	}
+
+	return slackBlock{
+		"type": "section",
+		"text": slackBlock{
+			"type": "mrkdwn",
+			"text": fmt.Sprintf("*%s* — %s\n%s", team.Number, team.Organization, detail),
+		},
+		"accessory": slackBlock{
+			"type": "button",
+			"text": slackBlock{
+				"type":  "plain_text",
+				"text":  "View team",
+				"emoji": true,
+			},
+			"url":       teamRobotEventsURL(team),
+			"action_id": "view_team",
+		},
+	}
+}
+
+// teamsAttachment builds a colored attachment containing a header and one
+// section block per team, so operators can visually scan each category of
+// change at large events.
+func teamsAttachment(title, color string, teams []robotevents.Team) slackAttachment {
+	blocks := []slackBlock{headerBlock(fmt.Sprintf("%s (%d)", title, len(teams)))}
+	for i, team := range teams {
+		if i > 0 {
+			blocks = append(blocks, slackBlock{"type": "divider"})
+		}
+		blocks = append(blocks, teamSectionBlock(team, ""))
+	}
+	return slackAttachment{Color: color, Blocks: blocks}
+}
+
+// changesAttachment builds a colored attachment for teams whose tracked
+// fields changed, listing the specific fields that differ under each team.
+func changesAttachment(title, color string, changes []diff.FieldChange) slackAttachment {
+	blocks := []slackBlock{headerBlock(fmt.Sprintf("%s (%d)", title, len(changes)))}
+	for i, change := range changes {
+		if i > 0 {
+			blocks = append(blocks, slackBlock{"type": "divider"})
+		}
+		blocks = append(blocks, teamSectionBlock(change.Team, strings.Join(change.Fields, "\n")))
+	}
+	return slackAttachment{Color: color, Blocks: blocks}
+}
+
+func headerBlock(text string) slackBlock {
+	return slackBlock{
+		"type": "header",
+		"text": slackBlock{
+			"type":  "plain_text",
+			"text":  text,
+			"emoji": true,
+		},
+	}
+}
+
+// BuildBlocksMessage builds the Block Kit payload for a registration check:
+// a header naming the event, a context block with its date/location, and a
+// colored attachment per category of change (added, removed, restored,
+// changed). It is split out from SendSlackMessage so it can be unit tested
+// without making HTTP calls.
+func BuildBlocksMessage(eventName string, event *robotevents.EventMeta, d diff.TeamDiff) []byte {
+	msg := slackMessage{
+		Blocks: []slackBlock{headerBlock(eventName)},
+	}
+
+	if event != nil {
+		dateRange := event.Dates.Start
+		if event.Dates.End != "" && event.Dates.End != event.Dates.Start {
+			dateRange = fmt.Sprintf("%s - %s", event.Dates.Start, event.Dates.End)
+		}
+		location := strings.TrimSpace(strings.Join([]string{event.Location.City, event.Location.Region, event.Location.Country}, ", "))
+		msg.Blocks = append(msg.Blocks, slackBlock{
+			"type": "context",
+			"elements": []slackBlock{
+				{"type": "mrkdwn", "text": fmt.Sprintf("%s | %s", dateRange, location)},
+			},
+		})
+	}
+
+	if len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Restored) == 0 && len(d.Changed) == 0 {
+		msg.Blocks = append(msg.Blocks, slackBlock{
+			"type": "section",
+			"text": slackBlock{"type": "mrkdwn", "text": "No registration changes."},
+		})
+		payload, _ := json.Marshal(msg)
+		return payload
+	}
+
+	if len(d.Removed) > 0 {
+		msg.Attachments = append(msg.Attachments, teamsAttachment("Missing teams", colorRemoved, d.Removed))
+	}
+	if len(d.Restored) > 0 {
+		msg.Attachments = append(msg.Attachments, teamsAttachment("Restored teams", colorRestored, d.Restored))
+	}
+	if len(d.Added) > 0 {
+		msg.Attachments = append(msg.Attachments, teamsAttachment("New registrations", colorAdded, d.Added))
+	}
+	if len(d.Changed) > 0 {
+		msg.Attachments = append(msg.Attachments, changesAttachment("Updated teams", colorChanged, d.Changed))
+	}
+
+	payload, _ := json.Marshal(msg)
+	return payload
+}
+
+// SendSlackMessage posts a Block Kit message summarizing a registration
+// diff to Slack.
+func SendSlackMessage(webhookURL, eventName string, event *robotevents.EventMeta, d diff.TeamDiff) error {
+	payloadBytes := BuildBlocksMessage(eventName, event, d)
+
+	resp, err := http.Post(webhookURL, "application/json", bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to send Slack message, status code: %d", resp.StatusCode)
+	}
+	return nil
+}
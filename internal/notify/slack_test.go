@@ -0,0 +1,58 @@
+package notify
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/penguinrobotics/registration-checker/internal/diff"
+	"github.com/penguinrobotics/registration-checker/internal/robotevents"
+)
+
+func TestBuildBlocksMessageNoChanges(t *testing.T) {
+	payload := BuildBlocksMessage("Test Event", nil, diff.TeamDiff{})
+
+	var msg slackMessage
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	if len(msg.Attachments) != 0 {
+		t.Fatalf("expected no attachments when there are no changes, got %d", len(msg.Attachments))
+	}
+	if len(msg.Blocks) != 2 {
+		t.Fatalf("expected header + no-changes section block, got %d blocks", len(msg.Blocks))
+	}
+}
+
+func TestBuildBlocksMessageAllCategories(t *testing.T) {
+	event := &robotevents.EventMeta{Name: "Test Event"}
+	event.Dates.Start = "2026-03-01"
+	event.Dates.End = "2026-03-02"
+	event.Location.City = "Austin"
+	event.Location.Region = "TX"
+
+	d := diff.TeamDiff{
+		Removed:  []robotevents.Team{{Number: "1234A", Organization: "Acme Robotics"}},
+		Restored: []robotevents.Team{{Number: "5678B", Organization: "Beta Robotics"}},
+		Added:    []robotevents.Team{{Number: "9999C", Organization: "Gamma Robotics"}},
+		Changed: []diff.FieldChange{
+			{Team: robotevents.Team{Number: "1111D", Organization: "Delta Robotics"}, Fields: []string{`Organization: "Delta" -> "Delta Robotics"`}},
+		},
+	}
+
+	payload := BuildBlocksMessage("Test Event", event, d)
+
+	var msg slackMessage
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	if len(msg.Attachments) != 4 {
+		t.Fatalf("expected one attachment per category, got %d", len(msg.Attachments))
+	}
+
+	wantColors := []string{colorRemoved, colorRestored, colorAdded, colorChanged}
+	for i, want := range wantColors {
+		if msg.Attachments[i].Color != want {
+			t.Errorf("attachment %d color = %q, want %q", i, msg.Attachments[i].Color, want)
+		}
+	}
+}
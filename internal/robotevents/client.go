@@ -0,0 +1,185 @@
+// Package robotevents is a thin client for the subset of the RobotEvents
+// (robotevents.com) v2 API that the registration checker needs: listing a
+// competition's registered teams and looking up an event's basic details.
+package robotevents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// teamsURLFormat is a var, not a const, so tests can point it at an
+// httptest.Server.
+var teamsURLFormat = "https://www.robotevents.com/api/v2/events/%s/teams?per_page=250&page=%d"
+
+const (
+	eventURLFormat = "https://www.robotevents.com/api/v2/events/%s"
+
+	maxRetries     = 5
+	initialBackoff = 500 * time.Millisecond
+)
+
+// Team represents an individual team in the response.
+type Team struct {
+	ID           int    `json:"id"`
+	Number       string `json:"number"`
+	TeamName     string `json:"team_name"`
+	RobotName    string `json:"robot_name"`
+	Organization string `json:"organization"`
+	Location     struct {
+		City     string `json:"city"`
+		Region   string `json:"region"`
+		Country  string `json:"country"`
+		Postcode string `json:"postcode"`
+	} `json:"location"`
+	Registered bool `json:"registered"`
+}
+
+// APIResponse represents the overall structure of the teams API response.
+type APIResponse struct {
+	Meta struct {
+		Total       int `json:"total"`
+		PerPage     int `json:"per_page"`
+		CurrentPage int `json:"current_page"`
+		LastPage    int `json:"last_page"`
+	} `json:"meta"`
+	Data []Team `json:"data"`
+}
+
+// EventMeta holds the event details used to give Slack messages context
+// beyond just the event name (date, location).
+type EventMeta struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Dates struct {
+		Start string `json:"start"`
+		End   string `json:"end"`
+	} `json:"dates"`
+	Location struct {
+		Venue   string `json:"venue"`
+		City    string `json:"city"`
+		Region  string `json:"region"`
+		Country string `json:"country"`
+	} `json:"location"`
+}
+
+// FetchTeams fetches every registered team for eventID, following
+// pagination (events the size of Worlds return many more than one page of
+// 250) and backing off on 429 responses.
+func FetchTeams(ctx context.Context, token, eventID string) (*APIResponse, error) {
+	all := &APIResponse{}
+
+	for page := 1; ; page++ {
+		url := fmt.Sprintf(teamsURLFormat, eventID, page)
+		var result APIResponse
+		if err := getJSON(ctx, token, url, &result); err != nil {
+			return nil, fmt.Errorf("fetching teams page %d: %w", page, err)
+		}
+
+		all.Data = append(all.Data, result.Data...)
+		all.Meta = result.Meta
+
+		if result.Meta.LastPage == 0 || result.Meta.CurrentPage >= result.Meta.LastPage {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+// FetchEventMeta fetches the event's details (name, date, location) used to
+// give Slack notifications more context than just the event name.
+func FetchEventMeta(token, eventID string) (*EventMeta, error) {
+	var result EventMeta
+	if err := getJSON(context.Background(), token, fmt.Sprintf(eventURLFormat, eventID), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// getJSON issues a GET request and decodes the JSON response into out,
+// retrying with exponential backoff when the API rate-limits the request.
+func getJSON(ctx context.Context, token, url string, out interface{}) error {
+	backoff := initialBackoff
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			resp.Body.Close()
+			if attempt >= maxRetries {
+				return fmt.Errorf("rate limited after %d attempts", attempt+1)
+			}
+			wait := retryAfter(resp.Header, backoff)
+			if err := sleep(ctx, wait); err != nil {
+				return err
+			}
+			backoff *= 2
+			continue
+		}
+
+		err = json.NewDecoder(resp.Body).Decode(out)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		// Even on success, an exhausted rate limit window (X-RateLimit-Remaining
+		// of 0) means the
+		// *next* request should slow down rather than run straight into a 429.
+		if remaining, ok := rateLimitRemaining(resp.Header); ok && remaining == 0 {
+			if err := sleep(ctx, retryAfter(resp.Header, backoff)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// retryAfter prefers the API's Retry-After header (seconds) and falls back
+// to the caller's current backoff duration.
+func retryAfter(header http.Header, fallback time.Duration) time.Duration {
+	if s := header.Get("Retry-After"); s != "" {
+		if seconds, err := strconv.Atoi(s); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return fallback
+}
+
+func rateLimitRemaining(header http.Header) (int, bool) {
+	s := header.Get("X-RateLimit-Remaining")
+	if s == "" {
+		return 0, false
+	}
+	remaining, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, false
+	}
+	return remaining, true
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,103 @@
+package robotevents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFetchTeamsPaginates(t *testing.T) {
+	pages := [][]Team{
+		{{ID: 1, Number: "100A"}, {ID: 2, Number: "100B"}},
+		{{ID: 3, Number: "100C"}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			fmt.Sscanf(p, "%d", &page)
+		}
+		if page < 1 || page > len(pages) {
+			t.Fatalf("unexpected page requested: %d", page)
+		}
+
+		resp := APIResponse{Data: pages[page-1]}
+		resp.Meta.CurrentPage = page
+		resp.Meta.LastPage = len(pages)
+		resp.Meta.Total = 3
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	origFormat := teamsURLFormat
+	teamsURLFormat = server.URL + "/?per_page=250&page=%[2]d&event=%[1]s"
+	defer func() { teamsURLFormat = origFormat }()
+
+	result, err := FetchTeams(context.Background(), "token", "123")
+	if err != nil {
+		t.Fatalf("FetchTeams: %v", err)
+	}
+	if len(result.Data) != 3 {
+		t.Fatalf("expected 3 teams across both pages, got %d", len(result.Data))
+	}
+}
+
+func TestFetchTeamsRetriesOn429(t *testing.T) {
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		resp := APIResponse{Data: []Team{{ID: 1, Number: "100A"}}}
+		resp.Meta.CurrentPage = 1
+		resp.Meta.LastPage = 1
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	origFormat := teamsURLFormat
+	teamsURLFormat = server.URL + "/?per_page=250&page=%[2]d&event=%[1]s"
+	defer func() { teamsURLFormat = origFormat }()
+
+	result, err := FetchTeams(context.Background(), "token", "123")
+	if err != nil {
+		t.Fatalf("FetchTeams: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected a retried request after the 429, got %d requests", requests)
+	}
+	if len(result.Data) != 1 {
+		t.Fatalf("expected 1 team, got %d", len(result.Data))
+	}
+}
+
+func TestFetchTeamsRespectsContextCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	origFormat := teamsURLFormat
+	teamsURLFormat = server.URL + "/?per_page=250&page=%[2]d&event=%[1]s"
+	defer func() { teamsURLFormat = origFormat }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := FetchTeams(ctx, "token", "123"); err == nil {
+		t.Fatal("expected an error when the context is canceled mid-backoff")
+	}
+}
@@ -0,0 +1,54 @@
+package store
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/penguinrobotics/registration-checker/internal/robotevents"
+)
+
+// ImportJSONSnapshots imports any legacy "<eventID>_teams.json" files found
+// in dir as a single historical snapshot, for events that don't already
+// have history in the store. It is safe to call on every startup: once an
+// event has history in the store, its legacy file is ignored.
+func (s *BoltStore) ImportJSONSnapshots(dir string) error {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), "_teams.json") {
+			continue
+		}
+		eventID := strings.TrimSuffix(entry.Name(), "_teams.json")
+
+		existing, err := s.LatestSnapshot(eventID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		var resp robotevents.APIResponse
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return err
+		}
+
+		if err := s.SaveSnapshot(eventID, entry.ModTime(), resp.Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
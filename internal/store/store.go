@@ -0,0 +1,130 @@
+// Package store persists registration snapshots with their full history,
+// backed by BoltDB, so operators can answer questions like "what dropped
+// in the last 24h" and so concurrent check/watch/serve runs don't race on
+// a single JSON file per event.
+package store
+
+import (
+	"encoding/json"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/penguinrobotics/registration-checker/internal/robotevents"
+)
+
+// Snapshot is one point-in-time capture of an event's registered teams.
+type Snapshot struct {
+	EventID   string
+	Timestamp time.Time
+	Teams     []robotevents.Team
+}
+
+// Store records and retrieves team snapshots per event.
+type Store interface {
+	// SaveSnapshot records teams as the state of eventID at ts.
+	SaveSnapshot(eventID string, ts time.Time, teams []robotevents.Team) error
+	// LatestSnapshot returns the most recent snapshot for eventID, or nil
+	// if none has been recorded yet.
+	LatestSnapshot(eventID string) (*Snapshot, error)
+	// Snapshots returns all snapshots for eventID at or after since, oldest
+	// first.
+	Snapshots(eventID string, since time.Time) ([]Snapshot, error)
+	Close() error
+}
+
+// BoltStore is a Store backed by a BoltDB file, with one bucket per event
+// and entries keyed by a fixed-width RFC3339 timestamp (UTC, nine
+// fractional digits) so a bucket's natural key order is chronological.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+var _ Store = (*BoltStore)(nil)
+
+// Open opens (creating if necessary) a BoltDB-backed Store at path.
+func Open(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	return &BoltStore{db: db}, nil
+}
+
+// Close releases the underlying BoltDB file lock.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+func timeKey(ts time.Time) []byte {
+	return []byte(ts.UTC().Format("2006-01-02T15:04:05.000000000Z07:00"))
+}
+
+// SaveSnapshot implements Store.
+func (s *BoltStore) SaveSnapshot(eventID string, ts time.Time, teams []robotevents.Team) error {
+	data, err := json.Marshal(teams)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(eventID))
+		if err != nil {
+			return err
+		}
+		return b.Put(timeKey(ts), data)
+	})
+}
+
+// LatestSnapshot implements Store.
+func (s *BoltStore) LatestSnapshot(eventID string) (*Snapshot, error) {
+	var snap *Snapshot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(eventID))
+		if b == nil {
+			return nil
+		}
+		k, v := b.Cursor().Last()
+		if k == nil {
+			return nil
+		}
+
+		ts, err := time.Parse(time.RFC3339Nano, string(k))
+		if err != nil {
+			return err
+		}
+		var teams []robotevents.Team
+		if err := json.Unmarshal(v, &teams); err != nil {
+			return err
+		}
+		snap = &Snapshot{EventID: eventID, Timestamp: ts, Teams: teams}
+		return nil
+	})
+	return snap, err
+}
+
+// Snapshots implements Store.
+func (s *BoltStore) Snapshots(eventID string, since time.Time) ([]Snapshot, error) {
+	var snaps []Snapshot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(eventID))
+		if b == nil {
+			return nil
+		}
+
+		c := b.Cursor()
+		for k, v := c.Seek(timeKey(since)); k != nil; k, v = c.Next() {
+			ts, err := time.Parse(time.RFC3339Nano, string(k))
+			if err != nil {
+				return err
+			}
+			var teams []robotevents.Team
+			if err := json.Unmarshal(v, &teams); err != nil {
+				return err
+			}
+			snaps = append(snaps, Snapshot{EventID: eventID, Timestamp: ts, Teams: teams})
+		}
+		return nil
+	})
+	return snaps, err
+}